@@ -0,0 +1,116 @@
+package dns
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+type Config struct {
+	server                string
+	port                  int
+	transport             string
+	timeout               int
+	keyname               string
+	keyalgo               string
+	keysecret             string
+	recursiveNameservers  []string
+	gssapi                *GSSAPIConfig
+	tlsServerName         string
+	tlsCAFile             string
+	tlsInsecureSkipVerify bool
+}
+
+// GSSAPIConfig carries the "gssapi" provider block used to authenticate
+// dynamic updates against Active Directory-integrated DNS via GSS-TSIG
+// (RFC 3645) instead of a static TSIG key. Exactly one of password/keytab is
+// expected to be set.
+type GSSAPIConfig struct {
+	realm    string
+	username string
+	password string
+	keytab   string
+}
+
+// DNSClient holds the dns.Client and the pieces of provider config that every
+// resource in this package needs in order to send a signed dynamic update.
+// recursiveNameservers is populated even when no update server is configured,
+// since data sources resolve independently of the RFC 2136 update flow.
+type DNSClient struct {
+	c                    *dns.Client
+	srv_addr             string
+	keyname              string
+	keyalgo              string
+	recursiveNameservers []string
+
+	gssapi       *GSSAPIConfig
+	gssMu        sync.RWMutex
+	gssClient    gssClient
+	gssKeyExpiry time.Time
+	gssKeyname   string
+	gssKeyalgo   string
+
+	// connPool holds idle connections for the "tcp"/"tls" transports so
+	// concurrent resource operations can reuse connections without
+	// serializing all provider traffic through a single one. It's left nil
+	// (and unused) for the connectionless "udp" transport.
+	connPool chan *dns.Conn
+}
+
+// dnsConnPoolSize bounds how many idle tcp/tls connections to the update
+// server are kept warm between exchanges. It doesn't cap concurrency itself
+// -- callers that find the pool empty just dial a fresh connection -- it
+// only bounds how many get reused rather than closed afterwards.
+const dnsConnPoolSize = 8
+
+// dnsClientConfigured reports whether the provider was configured with an
+// update server, which resources need but data sources do not.
+func dnsClientConfigured(meta interface{}) bool {
+	return meta != nil && meta.(*DNSClient).srv_addr != ""
+}
+
+func (c *Config) Client() (interface{}, error) {
+
+	client := new(dns.Client)
+	client.SingleInflight = true
+
+	switch c.transport {
+	case "", "udp":
+		// client.Net == "" means UDP, miekg/dns's default.
+	case "tcp":
+		client.Net = "tcp"
+	case "tls":
+		client.Net = "tcp-tls"
+		tlsConfig, err := tlsClientConfig(c)
+		if err != nil {
+			return nil, err
+		}
+		client.TLSConfig = tlsConfig
+	default:
+		return nil, fmt.Errorf("\"transport\" must be one of \"udp\", \"tcp\" or \"tls\", got: %s", c.transport)
+	}
+
+	var srv_addr string
+	if c.server != "" {
+		if c.keyname != "" {
+			client.TsigSecret = map[string]string{c.keyname: c.keysecret}
+		}
+		srv_addr = fmt.Sprintf("%s:%d", c.server, c.port)
+	}
+
+	dnsClient := &DNSClient{
+		c:                    client,
+		srv_addr:             srv_addr,
+		keyname:              c.keyname,
+		keyalgo:              c.keyalgo,
+		recursiveNameservers: c.recursiveNameservers,
+		gssapi:               c.gssapi,
+	}
+	if client.Net != "" {
+		dnsClient.connPool = make(chan *dns.Conn, dnsConnPoolSize)
+	}
+
+	return dnsClient, nil
+}
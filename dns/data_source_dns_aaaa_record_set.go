@@ -0,0 +1,50 @@
+package dns
+
+import (
+	"net"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+func dataSourceDnsAAAARecordSet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDnsAAAARecordSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"host": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"addrs": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDnsAAAARecordSetRead(d *schema.ResourceData, meta interface{}) error {
+
+	host := d.Get("host").(string)
+
+	r, err := dnsRecursiveLookup(meta, host, dns.TypeAAAA)
+	if err != nil {
+		return err
+	}
+
+	addrs := make([]string, 0, len(r.Answer))
+	for _, record := range r.Answer {
+		addr, err := getAAAAVal(record)
+		if err != nil {
+			continue
+		}
+		addrs = append(addrs, net.ParseIP(addr).String())
+	}
+
+	d.Set("addrs", addrs)
+	d.SetId(host)
+
+	return nil
+}
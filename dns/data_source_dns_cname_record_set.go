@@ -0,0 +1,48 @@
+package dns
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+func dataSourceDnsCNAMERecordSet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDnsCNAMERecordSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"host": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cname": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDnsCNAMERecordSetRead(d *schema.ResourceData, meta interface{}) error {
+
+	host := d.Get("host").(string)
+
+	r, err := dnsRecursiveLookup(meta, host, dns.TypeCNAME)
+	if err != nil {
+		return err
+	}
+	if len(r.Answer) == 0 {
+		return fmt.Errorf("Error querying DNS record: no CNAME found for %s", host)
+	}
+
+	cname, err := getCNAMEVal(r.Answer[0])
+	if err != nil {
+		return err
+	}
+
+	d.Set("cname", cname)
+	d.SetId(host)
+
+	return nil
+}
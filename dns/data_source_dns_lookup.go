@@ -0,0 +1,64 @@
+package dns
+
+import (
+	"fmt"
+
+	"github.com/miekg/dns"
+)
+
+// dnsRecursiveLookup issues a standard recursive query for name/rtype. It is
+// independent of the update server / TSIG config: it uses the
+// recursive_nameservers configured on the provider, falling back to the
+// system resolver (/etc/resolv.conf) when none are configured.
+func dnsRecursiveLookup(meta interface{}, name string, rtype uint16) (*dns.Msg, error) {
+
+	nameservers, err := dnsRecursiveNameservers(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(name), rtype)
+	msg.RecursionDesired = true
+
+	c := new(dns.Client)
+
+	var lastErr error
+	for _, ns := range nameservers {
+		r, _, err := c.Exchange(msg, ns)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			lastErr = fmt.Errorf("Error querying DNS record: %v", r.Rcode)
+			continue
+		}
+		return r, nil
+	}
+
+	return nil, fmt.Errorf("Error querying DNS record: %s", lastErr)
+}
+
+func dnsRecursiveNameservers(meta interface{}) ([]string, error) {
+
+	if meta != nil {
+		if ns := meta.(*DNSClient).recursiveNameservers; len(ns) > 0 {
+			return ns, nil
+		}
+	}
+
+	conf, err := dns.ClientConfigFromFile("/etc/resolv.conf")
+	if err != nil {
+		return nil, fmt.Errorf("Error reading /etc/resolv.conf: %s", err)
+	}
+
+	nameservers := make([]string, 0, len(conf.Servers))
+	for _, s := range conf.Servers {
+		nameservers = append(nameservers, fmt.Sprintf("%s:%s", s, conf.Port))
+	}
+	if len(nameservers) == 0 {
+		return nil, fmt.Errorf("no nameservers found in /etc/resolv.conf")
+	}
+	return nameservers, nil
+}
@@ -0,0 +1,62 @@
+package dns
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+func dataSourceDnsMXRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDnsMXRecordSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"mx": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preference": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"exchange": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDnsMXRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+
+	domain := d.Get("domain").(string)
+
+	r, err := dnsRecursiveLookup(meta, domain, dns.TypeMX)
+	if err != nil {
+		return err
+	}
+
+	mx := make([]map[string]interface{}, 0, len(r.Answer))
+	for _, record := range r.Answer {
+		rec, err := getMXVal(record)
+		if err != nil {
+			continue
+		}
+		mx = append(mx, map[string]interface{}{
+			"preference": int(rec.Preference),
+			"exchange":   rec.Mx,
+		})
+	}
+
+	d.Set("mx", mx)
+	d.SetId(domain)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package dns
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+func dataSourceDnsNSRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDnsNSRecordSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"host": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"nameservers": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDnsNSRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+
+	host := d.Get("host").(string)
+
+	r, err := dnsRecursiveLookup(meta, host, dns.TypeNS)
+	if err != nil {
+		return err
+	}
+
+	nameservers := make([]string, 0, len(r.Answer))
+	for _, record := range r.Answer {
+		ns, err := getNSVal(record)
+		if err != nil {
+			continue
+		}
+		nameservers = append(nameservers, ns)
+	}
+
+	d.Set("nameservers", nameservers)
+	d.SetId(host)
+
+	return nil
+}
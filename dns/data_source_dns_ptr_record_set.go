@@ -0,0 +1,53 @@
+package dns
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+func dataSourceDnsPTRRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDnsPTRRecordSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"ip_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ptr": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDnsPTRRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+
+	ip_address := d.Get("ip_address").(string)
+
+	arpa, err := dns.ReverseAddr(ip_address)
+	if err != nil {
+		return fmt.Errorf("Error deriving reverse lookup name for %q: %s", ip_address, err)
+	}
+
+	r, err := dnsRecursiveLookup(meta, arpa, dns.TypePTR)
+	if err != nil {
+		return err
+	}
+	if len(r.Answer) == 0 {
+		return fmt.Errorf("Error querying DNS record: no PTR found for %s", ip_address)
+	}
+
+	ptr, err := getPTRVal(r.Answer[0])
+	if err != nil {
+		return err
+	}
+
+	d.Set("ptr", ptr)
+	d.SetId(ip_address)
+
+	return nil
+}
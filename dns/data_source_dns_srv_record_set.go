@@ -0,0 +1,72 @@
+package dns
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+func dataSourceDnsSRVRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDnsSRVRecordSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"service": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"srv": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"weight": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"port": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"target": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDnsSRVRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+
+	service := d.Get("service").(string)
+
+	r, err := dnsRecursiveLookup(meta, service, dns.TypeSRV)
+	if err != nil {
+		return err
+	}
+
+	srv := make([]map[string]interface{}, 0, len(r.Answer))
+	for _, record := range r.Answer {
+		rec, err := getSRVVal(record)
+		if err != nil {
+			continue
+		}
+		srv = append(srv, map[string]interface{}{
+			"priority": int(rec.Priority),
+			"weight":   int(rec.Weight),
+			"port":     int(rec.Port),
+			"target":   rec.Target,
+		})
+	}
+
+	d.Set("srv", srv)
+	d.SetId(service)
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package dns
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+func dataSourceDnsTXTRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDnsTXTRecordSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"host": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"record": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"records": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDnsTXTRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+
+	host := d.Get("host").(string)
+
+	r, err := dnsRecursiveLookup(meta, host, dns.TypeTXT)
+	if err != nil {
+		return err
+	}
+
+	records := make([]string, 0, len(r.Answer))
+	for _, record := range r.Answer {
+		txt, err := getTXTVal(record)
+		if err != nil {
+			continue
+		}
+		records = append(records, txt)
+	}
+
+	d.Set("records", records)
+	if len(records) > 0 {
+		d.Set("record", records[0])
+	}
+	d.SetId(host)
+
+	return nil
+}
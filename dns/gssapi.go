@@ -0,0 +1,63 @@
+package dns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bodgit/tsig"
+	"github.com/bodgit/tsig/gss"
+)
+
+// gssClient is the subset of *gss.Client the provider depends on, so tests
+// can substitute a fake negotiated context.
+type gssClient interface {
+	Close() error
+}
+
+// ensureGSSContext negotiates a GSS-TSIG (RFC 3645) security context with the
+// update server the first time it's needed, and transparently renegotiates
+// once the previous ticket has expired. It returns the keyname/keyalgo
+// callers should TSIG-sign with, computed under gssMu so a renegotiation on
+// one goroutine can never race a caller reading a half-written key name on
+// another (Terraform runs CRUD concurrently across resources by default).
+func (c *DNSClient) ensureGSSContext() (string, string, error) {
+
+	if c.gssapi == nil {
+		return c.keyname, c.keyalgo, nil
+	}
+
+	c.gssMu.Lock()
+	defer c.gssMu.Unlock()
+
+	if c.gssClient != nil && time.Now().Before(c.gssKeyExpiry) {
+		return c.gssKeyname, c.gssKeyalgo, nil
+	}
+
+	if c.gssClient != nil {
+		c.gssClient.Close()
+	}
+
+	client, err := gss.NewClient(c.c)
+	if err != nil {
+		return "", "", fmt.Errorf("Error creating GSS-TSIG client: %s", err)
+	}
+
+	var keyname string
+	var expiry time.Time
+	if c.gssapi.keytab != "" {
+		keyname, expiry, err = client.NegotiateContextWithKeytab(c.srv_addr, c.gssapi.realm, c.gssapi.username, c.gssapi.keytab)
+	} else {
+		keyname, expiry, err = client.NegotiateContextWithCredentials(c.srv_addr, c.gssapi.realm, c.gssapi.username, c.gssapi.password)
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("Error negotiating GSS-TSIG context with %s: %s", c.srv_addr, err)
+	}
+
+	c.gssClient = client
+	c.gssKeyExpiry = expiry
+	c.gssKeyname = keyname
+	c.gssKeyalgo = tsig.GSS
+	c.c.TsigProvider = client
+
+	return c.gssKeyname, c.gssKeyalgo, nil
+}
@@ -0,0 +1,195 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// defaultDNSPort is the port appended to a "recursive_nameservers" entry
+// that doesn't already specify one, matching the port dns.ClientConfigFromFile
+// defaults to for the /etc/resolv.conf fallback path.
+const defaultDNSPort = "53"
+
+// withDefaultPort appends defaultDNSPort to ns if it doesn't already carry a
+// port, so a bare IP like "8.8.8.8" (the natural, and only documented, way to
+// write a nameserver) doesn't fail at query time with a cryptic "missing
+// port in address" error from dns.Client.Exchange.
+func withDefaultPort(ns string) string {
+	if _, _, err := net.SplitHostPort(ns); err == nil {
+		return ns
+	}
+	return net.JoinHostPort(ns, defaultDNSPort)
+}
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"update_server": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  53,
+			},
+			"key_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"key_algorithm": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"key_secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"recursive_nameservers": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"transport": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "udp",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "udp" && value != "tcp" && value != "tls" {
+						errors = append(errors, fmt.Errorf("%q must be one of \"udp\", \"tcp\" or \"tls\", got: %s", k, value))
+					}
+					return
+				},
+			},
+			"tls_server_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tls_ca_file": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tls_insecure_skip_verify": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"gssapi": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"key_name", "key_algorithm", "key_secret"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"realm": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"username": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"password": &schema.Schema{
+							Type:          schema.TypeString,
+							Optional:      true,
+							Sensitive:     true,
+							ConflictsWith: []string{"gssapi.0.keytab"},
+						},
+						"keytab": &schema.Schema{
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"gssapi.0.password"},
+						},
+					},
+				},
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"dns_a_record_set":    resourceDnsARecordSet(),
+			"dns_acme_challenge":  resourceDnsAcmeChallenge(),
+			"dns_aaaa_record_set": resourceDnsAAAARecordSet(),
+			"dns_cname_record":    resourceDnsCNAMERecord(),
+			"dns_mx_record_set":   resourceDnsMXRecordSet(),
+			"dns_ns_record_set":   resourceDnsNSRecordSet(),
+			"dns_ptr_record":      resourceDnsPTRRecord(),
+			"dns_record_set":      resourceDnsRecordSet(),
+			"dns_srv_record_set":  resourceDnsSRVRecordSet(),
+			"dns_txt_record_set":  resourceDnsTXTRecordSet(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"dns_a_record_set":     dataSourceDnsARecordSet(),
+			"dns_aaaa_record_set":  dataSourceDnsAAAARecordSet(),
+			"dns_cname_record_set": dataSourceDnsCNAMERecordSet(),
+			"dns_mx_record_set":    dataSourceDnsMXRecordSet(),
+			"dns_ns_record_set":    dataSourceDnsNSRecordSet(),
+			"dns_ptr_record_set":   dataSourceDnsPTRRecordSet(),
+			"dns_srv_record_set":   dataSourceDnsSRVRecordSet(),
+			"dns_txt_record_set":   dataSourceDnsTXTRecordSet(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+
+	server := d.Get("update_server").(string)
+
+	keyname := d.Get("key_name").(string)
+	keyalgo := d.Get("key_algorithm").(string)
+	if keyname != "" && keyalgo == "" {
+		return nil, fmt.Errorf("\"key_algorithm\" must be set when \"key_name\" is set")
+	}
+
+	var recursiveNameservers []string
+	for _, ns := range d.Get("recursive_nameservers").([]interface{}) {
+		recursiveNameservers = append(recursiveNameservers, withDefaultPort(ns.(string)))
+	}
+
+	gssapi, err := expandGSSAPIConfig(d.Get("gssapi").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	config := Config{
+		server:                server,
+		port:                  d.Get("port").(int),
+		transport:             d.Get("transport").(string),
+		keyname:               keyname,
+		keyalgo:               keyalgo,
+		keysecret:             d.Get("key_secret").(string),
+		recursiveNameservers:  recursiveNameservers,
+		gssapi:                gssapi,
+		tlsServerName:         d.Get("tls_server_name").(string),
+		tlsCAFile:             d.Get("tls_ca_file").(string),
+		tlsInsecureSkipVerify: d.Get("tls_insecure_skip_verify").(bool),
+	}
+
+	return config.Client()
+}
+
+func expandGSSAPIConfig(blocks []interface{}) (*GSSAPIConfig, error) {
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+
+	b := blocks[0].(map[string]interface{})
+	password := b["password"].(string)
+	keytab := b["keytab"].(string)
+
+	if password == "" && keytab == "" {
+		return nil, fmt.Errorf("\"gssapi\" requires either \"password\" or \"keytab\" to be set")
+	}
+
+	return &GSSAPIConfig{
+		realm:    b["realm"].(string),
+		username: b["username"].(string),
+		password: password,
+		keytab:   keytab,
+	}, nil
+}
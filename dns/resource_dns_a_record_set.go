@@ -61,7 +61,7 @@ func resourceDnsARecordSetCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceDnsARecordSetRead(d *schema.ResourceData, meta interface{}) error {
 
-	if meta != nil {
+	if dnsClientConfigured(meta) {
 
 		rec_name := d.Get("name").(string)
 		rec_zone := d.Get("zone").(string)
@@ -72,13 +72,12 @@ func resourceDnsARecordSetRead(d *schema.ResourceData, meta interface{}) error {
 
 		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
 
-		c := meta.(*DNSClient).c
-		srv_addr := meta.(*DNSClient).srv_addr
+		client := meta.(*DNSClient)
 
 		msg := new(dns.Msg)
 		msg.SetQuestion(rec_fqdn, dns.TypeA)
 
-		r, _, err := c.Exchange(msg, srv_addr)
+		r, err := client.exchange(msg)
 		if err != nil {
 			return fmt.Errorf("Error querying DNS record: %s", err)
 		}
@@ -122,7 +121,7 @@ func resourceDnsARecordSetRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceDnsARecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	if meta != nil {
+	if dnsClientConfigured(meta) {
 
 		rec_name := d.Get("name").(string)
 		rec_zone := d.Get("zone").(string)
@@ -134,15 +133,6 @@ func resourceDnsARecordSetUpdate(d *schema.ResourceData, meta interface{}) error
 
 		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
 
-		c := meta.(*DNSClient).c
-		srv_addr := meta.(*DNSClient).srv_addr
-		keyname := meta.(*DNSClient).keyname
-		keyalgo := meta.(*DNSClient).keyalgo
-
-		msg := new(dns.Msg)
-
-		msg.SetUpdate(rec_zone)
-
 		if d.HasChange("addresses") {
 			o, n := d.GetChange("addresses")
 			os := o.(*schema.Set)
@@ -150,29 +140,22 @@ func resourceDnsARecordSetUpdate(d *schema.ResourceData, meta interface{}) error
 			remove := os.Difference(ns).List()
 			add := ns.Difference(os).List()
 
+			var rr_remove, rr_insert []dns.RR
+
 			// Loop through all the old addresses and remove them
 			for _, addr := range remove {
-				rr_remove, _ := dns.NewRR(fmt.Sprintf("%s %d A %s", rec_fqdn, ttl, addr.(string)))
-				msg.Remove([]dns.RR{rr_remove})
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d A %s", rec_fqdn, ttl, addr.(string)))
+				rr_remove = append(rr_remove, rr)
 			}
 			// Loop through all the new addresses and insert them
 			for _, addr := range add {
-				rr_insert, _ := dns.NewRR(fmt.Sprintf("%s %d A %s", rec_fqdn, ttl, addr.(string)))
-				msg.Insert([]dns.RR{rr_insert})
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d A %s", rec_fqdn, ttl, addr.(string)))
+				rr_insert = append(rr_insert, rr)
 			}
 
-			if keyname != "" {
-				msg.SetTsig(keyname, keyalgo, 300, time.Now().Unix())
-			}
-
-			r, _, err := c.Exchange(msg, srv_addr)
-			if err != nil {
-				d.SetId("")
-				return fmt.Errorf("Error updating DNS record: %s", err)
-			}
-			if r.Rcode != dns.RcodeSuccess {
+			if err := dnsRecordSetUpdate(meta, rec_zone, dns.TypeA, rr_remove, rr_insert); err != nil {
 				d.SetId("")
-				return fmt.Errorf("Error updating DNS record: %v", r.Rcode)
+				return err
 			}
 
 			addresses := ns
@@ -187,7 +170,7 @@ func resourceDnsARecordSetUpdate(d *schema.ResourceData, meta interface{}) error
 
 func resourceDnsARecordSetDelete(d *schema.ResourceData, meta interface{}) error {
 
-	if meta != nil {
+	if dnsClientConfigured(meta) {
 
 		rec_name := d.Get("name").(string)
 		rec_zone := d.Get("zone").(string)
@@ -198,32 +181,73 @@ func resourceDnsARecordSetDelete(d *schema.ResourceData, meta interface{}) error
 
 		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
 
-		c := meta.(*DNSClient).c
-		srv_addr := meta.(*DNSClient).srv_addr
-		keyname := meta.(*DNSClient).keyname
-		keyalgo := meta.(*DNSClient).keyalgo
+		rr_remove, _ := dns.NewRR(fmt.Sprintf("%s 0 A", rec_fqdn))
 
-		msg := new(dns.Msg)
+		return dnsRecordSetDelete(meta, rec_zone, dns.TypeA, []dns.RR{rr_remove})
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
 
-		msg.SetUpdate(rec_zone)
+// dnsRecordSetUpdate sends an RFC 2136 dynamic update removing "remove" and
+// inserting "insert", TSIG-signing the message if the provider is
+// configured with a key. recType is only used to annotate error messages, so
+// every per-record-type resource in this package can share this code path
+// regardless of which dns.Type it manages.
+func dnsRecordSetUpdate(meta interface{}, zone string, recType uint16, remove, insert []dns.RR) error {
+
+	client := meta.(*DNSClient)
+	keyname, keyalgo, err := client.ensureGSSContext()
+	if err != nil {
+		return err
+	}
 
-		rr_remove, _ := dns.NewRR(fmt.Sprintf("%s 0 A", rec_fqdn))
-		msg.RemoveRRset([]dns.RR{rr_remove})
+	msg := new(dns.Msg)
+	msg.SetUpdate(zone)
+	msg.Remove(remove)
+	msg.Insert(insert)
 
-		if keyname != "" {
-			msg.SetTsig(keyname, keyalgo, 300, time.Now().Unix())
-		}
+	if keyname != "" {
+		msg.SetTsig(keyname, keyalgo, 300, time.Now().Unix())
+	}
 
-		r, _, err := c.Exchange(msg, srv_addr)
-		if err != nil {
-			return fmt.Errorf("Error deleting DNS record: %s", err)
-		}
-		if r.Rcode != dns.RcodeSuccess {
-			return fmt.Errorf("Error deleting DNS record: %v", r.Rcode)
-		}
+	r, err := client.exchange(msg)
+	if err != nil {
+		return fmt.Errorf("Error updating DNS record (%s): %s", dns.TypeToString[recType], err)
+	}
+	if r.Rcode != dns.RcodeSuccess {
+		return fmt.Errorf("Error updating DNS record (%s): %v", dns.TypeToString[recType], r.Rcode)
+	}
 
-		return nil
-	} else {
-		return fmt.Errorf("update server is not set")
+	return nil
+}
+
+// dnsRecordSetDelete sends an RFC 2136 dynamic update removing the RRset(s)
+// in "remove", TSIG-signing the message if the provider is configured with a
+// key.
+func dnsRecordSetDelete(meta interface{}, zone string, recType uint16, remove []dns.RR) error {
+
+	client := meta.(*DNSClient)
+	keyname, keyalgo, err := client.ensureGSSContext()
+	if err != nil {
+		return err
+	}
+
+	msg := new(dns.Msg)
+	msg.SetUpdate(zone)
+	msg.RemoveRRset(remove)
+
+	if keyname != "" {
+		msg.SetTsig(keyname, keyalgo, 300, time.Now().Unix())
 	}
+
+	r, err := client.exchange(msg)
+	if err != nil {
+		return fmt.Errorf("Error deleting DNS record (%s): %s", dns.TypeToString[recType], err)
+	}
+	if r.Rcode != dns.RcodeSuccess {
+		return fmt.Errorf("Error deleting DNS record (%s): %v", dns.TypeToString[recType], r.Rcode)
+	}
+
+	return nil
 }
@@ -0,0 +1,187 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+func resourceDnsAAAARecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsAAAARecordSetCreate,
+		Read:   resourceDnsAAAARecordSetRead,
+		Update: resourceDnsAAAARecordSetUpdate,
+		Delete: resourceDnsAAAARecordSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"addresses": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+func resourceDnsAAAARecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+
+	rec_name := d.Get("name").(string)
+	rec_zone := d.Get("zone").(string)
+
+	if rec_zone != dns.Fqdn(rec_zone) {
+		return fmt.Errorf("Error creating DNS record: \"zone\" should be an FQDN")
+	}
+
+	rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+	d.SetId(rec_fqdn)
+
+	return resourceDnsAAAARecordSetUpdate(d, meta)
+}
+
+func resourceDnsAAAARecordSetRead(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error reading DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		client := meta.(*DNSClient)
+
+		msg := new(dns.Msg)
+		msg.SetQuestion(rec_fqdn, dns.TypeAAAA)
+
+		r, err := client.exchange(msg)
+		if err != nil {
+			return fmt.Errorf("Error querying DNS record: %s", err)
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			return fmt.Errorf("Error querying DNS record: %v", r.Rcode)
+		}
+
+		addresses := schema.NewSet(schema.HashString, nil)
+		for _, record := range r.Answer {
+			addr, err := getAAAAVal(record)
+			if err != nil {
+				return fmt.Errorf("Error querying DNS record: %s", err)
+			}
+
+			// This ensures the IPv6 address is formatted consistently
+			ip := net.ParseIP(addr)
+			if ip == nil {
+				return fmt.Errorf("Error parsing IPv6 address: %s", addr)
+			}
+			addresses.Add(ip.String())
+		}
+
+		// This ensures the IPv6 addresses are formatted consistently
+		expected := schema.NewSet(schema.HashString, nil)
+		for _, addr := range d.Get("addresses").(*schema.Set).List() {
+			ip := net.ParseIP(addr.(string))
+			if ip == nil {
+				return fmt.Errorf("Error parsing IPv6 address: %s", addr)
+			}
+			expected.Add(ip.String())
+		}
+		if !addresses.Equal(expected) {
+			d.SetId("")
+			return fmt.Errorf("DNS record differs")
+		}
+		return nil
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
+
+func resourceDnsAAAARecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+		ttl := d.Get("ttl").(int)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		if d.HasChange("addresses") {
+			o, n := d.GetChange("addresses")
+			os := o.(*schema.Set)
+			ns := n.(*schema.Set)
+			remove := os.Difference(ns).List()
+			add := ns.Difference(os).List()
+
+			var rr_remove, rr_insert []dns.RR
+
+			for _, addr := range remove {
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d AAAA %s", rec_fqdn, ttl, addr.(string)))
+				rr_remove = append(rr_remove, rr)
+			}
+			for _, addr := range add {
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d AAAA %s", rec_fqdn, ttl, addr.(string)))
+				rr_insert = append(rr_insert, rr)
+			}
+
+			if err := dnsRecordSetUpdate(meta, rec_zone, dns.TypeAAAA, rr_remove, rr_insert); err != nil {
+				d.SetId("")
+				return err
+			}
+
+			addresses := ns
+			d.Set("addresses", addresses)
+		}
+
+		return resourceDnsAAAARecordSetRead(d, meta)
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
+
+func resourceDnsAAAARecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		rr_remove, _ := dns.NewRR(fmt.Sprintf("%s 0 AAAA", rec_fqdn))
+
+		return dnsRecordSetDelete(meta, rec_zone, dns.TypeAAAA, []dns.RR{rr_remove})
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
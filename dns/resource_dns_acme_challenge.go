@@ -0,0 +1,213 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+// resourceDnsAcmeChallenge publishes the TXT record an ACME dns-01 challenge
+// expects at "_acme-challenge.<domain>" and blocks until every authoritative
+// nameserver for the zone is observed serving it, so that an external ACME
+// client driven right after "terraform apply" doesn't race DNS propagation.
+func resourceDnsAcmeChallenge() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsAcmeChallengeCreate,
+		Read:   resourceDnsAcmeChallengeRead,
+		Delete: resourceDnsAcmeChallengeDelete,
+
+		Schema: map[string]*schema.Schema{
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_authorization": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  60,
+			},
+			"propagation_timeout": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  60,
+			},
+			"polling_interval": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  5,
+			},
+			"recursive_nameservers": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceDnsAcmeChallengeCreate(d *schema.ResourceData, meta interface{}) error {
+
+	if !dnsClientConfigured(meta) {
+		return fmt.Errorf("update server is not set")
+	}
+
+	rec_zone := d.Get("zone").(string)
+	if rec_zone != dns.Fqdn(rec_zone) {
+		return fmt.Errorf("Error creating DNS record: \"zone\" should be an FQDN")
+	}
+
+	challenge_fqdn := resourceDnsAcmeChallengeFqdn(d)
+	value := d.Get("key_authorization").(string)
+	ttl := d.Get("ttl").(int)
+
+	rr, err := dns.NewRR(fmt.Sprintf("%s %d TXT %s", challenge_fqdn, ttl, txtQuote(value)))
+	if err != nil {
+		return fmt.Errorf("Error building DNS record: %s", err)
+	}
+
+	if err := dnsRecordSetUpdate(meta, rec_zone, dns.TypeTXT, nil, []dns.RR{rr}); err != nil {
+		return err
+	}
+
+	d.SetId(challenge_fqdn)
+
+	timeout := time.Duration(d.Get("propagation_timeout").(int)) * time.Second
+	interval := time.Duration(d.Get("polling_interval").(int)) * time.Second
+
+	var recursiveNameservers []string
+	for _, ns := range d.Get("recursive_nameservers").([]interface{}) {
+		recursiveNameservers = append(recursiveNameservers, ns.(string))
+	}
+	if len(recursiveNameservers) > 0 {
+		meta = &DNSClient{
+			c:                    meta.(*DNSClient).c,
+			srv_addr:             meta.(*DNSClient).srv_addr,
+			keyname:              meta.(*DNSClient).keyname,
+			keyalgo:              meta.(*DNSClient).keyalgo,
+			recursiveNameservers: recursiveNameservers,
+		}
+	}
+
+	return resourceDnsAcmeChallengeWaitForPropagation(meta, rec_zone, challenge_fqdn, value, timeout, interval)
+}
+
+func resourceDnsAcmeChallengeRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceDnsAcmeChallengeDelete(d *schema.ResourceData, meta interface{}) error {
+
+	if !dnsClientConfigured(meta) {
+		return fmt.Errorf("update server is not set")
+	}
+
+	rec_zone := d.Get("zone").(string)
+	challenge_fqdn := resourceDnsAcmeChallengeFqdn(d)
+
+	rr, _ := dns.NewRR(fmt.Sprintf("%s 0 TXT", challenge_fqdn))
+
+	return dnsRecordSetDelete(meta, rec_zone, dns.TypeTXT, []dns.RR{rr})
+}
+
+func resourceDnsAcmeChallengeFqdn(d *schema.ResourceData) string {
+	return fmt.Sprintf("_acme-challenge.%s", dns.Fqdn(d.Get("domain").(string)))
+}
+
+// resourceDnsAcmeChallengeWaitForPropagation polls every authoritative
+// nameserver for zone directly (bypassing caches) until each one answers the
+// TXT query for fqdn with value, or timeout elapses.
+func resourceDnsAcmeChallengeWaitForPropagation(meta interface{}, zone, fqdn, value string, timeout, interval time.Duration) error {
+
+	authNS, err := resourceDnsAcmeChallengeAuthNS(meta, zone)
+	if err != nil {
+		return fmt.Errorf("Error discovering authoritative nameservers for %q: %s", zone, err)
+	}
+
+	deadline := time.Now().Add(timeout)
+	c := new(dns.Client)
+
+	for {
+		pending := map[string]bool{}
+		for _, ns := range authNS {
+			pending[ns] = true
+		}
+
+		for ns := range pending {
+			msg := new(dns.Msg)
+			msg.SetQuestion(fqdn, dns.TypeTXT)
+			msg.RecursionDesired = false
+
+			r, _, err := c.Exchange(msg, ns)
+			if err != nil {
+				continue
+			}
+			for _, record := range r.Answer {
+				txt, err := getTXTVal(record)
+				if err == nil && txt == value {
+					delete(pending, ns)
+					break
+				}
+			}
+		}
+
+		if len(pending) == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("Timed out waiting for %q to propagate to all authoritative nameservers for %q", fqdn, zone)
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// resourceDnsAcmeChallengeAuthNS resolves the zone's NS records, then the
+// address of each nameserver, returning "host:53" targets suitable for a
+// direct (non-recursive) query.
+func resourceDnsAcmeChallengeAuthNS(meta interface{}, zone string) ([]string, error) {
+
+	r, err := dnsRecursiveLookup(meta, zone, dns.TypeNS)
+	if err != nil {
+		return nil, err
+	}
+
+	var authNS []string
+	for _, record := range r.Answer {
+		ns, err := getNSVal(record)
+		if err != nil {
+			continue
+		}
+
+		addrs, err := net.LookupHost(ns)
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			authNS = append(authNS, net.JoinHostPort(addr, "53"))
+		}
+	}
+
+	if len(authNS) == 0 {
+		return nil, fmt.Errorf("no authoritative nameservers found")
+	}
+
+	return authNS, nil
+}
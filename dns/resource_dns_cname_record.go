@@ -0,0 +1,163 @@
+package dns
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+func resourceDnsCNAMERecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsCNAMERecordCreate,
+		Read:   resourceDnsCNAMERecordRead,
+		Update: resourceDnsCNAMERecordUpdate,
+		Delete: resourceDnsCNAMERecordDelete,
+
+		Schema: map[string]*schema.Schema{
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cname": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+func resourceDnsCNAMERecordCreate(d *schema.ResourceData, meta interface{}) error {
+
+	rec_name := d.Get("name").(string)
+	rec_zone := d.Get("zone").(string)
+
+	if rec_zone != dns.Fqdn(rec_zone) {
+		return fmt.Errorf("Error creating DNS record: \"zone\" should be an FQDN")
+	}
+
+	rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+	d.SetId(rec_fqdn)
+
+	return resourceDnsCNAMERecordUpdate(d, meta)
+}
+
+func resourceDnsCNAMERecordRead(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error reading DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		client := meta.(*DNSClient)
+
+		msg := new(dns.Msg)
+		msg.SetQuestion(rec_fqdn, dns.TypeCNAME)
+
+		r, err := client.exchange(msg)
+		if err != nil {
+			return fmt.Errorf("Error querying DNS record: %s", err)
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			return fmt.Errorf("Error querying DNS record: %v", r.Rcode)
+		}
+		if len(r.Answer) > 1 {
+			return fmt.Errorf("Error querying DNS record: multiple responses received")
+		}
+		if len(r.Answer) == 1 {
+			cname, err := getCNAMEVal(r.Answer[0])
+			if err != nil {
+				return fmt.Errorf("Error querying DNS record: %s", err)
+			}
+			d.Set("cname", cname)
+		} else {
+			d.SetId("")
+		}
+
+		return nil
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
+
+func resourceDnsCNAMERecordUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+		ttl := d.Get("ttl").(int)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		if d.HasChange("cname") {
+			o, n := d.GetChange("cname")
+
+			var rr_remove, rr_insert []dns.RR
+
+			if o.(string) != "" {
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d CNAME %s", rec_fqdn, ttl, dns.Fqdn(o.(string))))
+				rr_remove = append(rr_remove, rr)
+			}
+			if n.(string) != "" {
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d CNAME %s", rec_fqdn, ttl, dns.Fqdn(n.(string))))
+				rr_insert = append(rr_insert, rr)
+			}
+
+			if err := dnsRecordSetUpdate(meta, rec_zone, dns.TypeCNAME, rr_remove, rr_insert); err != nil {
+				d.SetId("")
+				return err
+			}
+
+			d.Set("cname", n)
+		}
+
+		return resourceDnsCNAMERecordRead(d, meta)
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
+
+func resourceDnsCNAMERecordDelete(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		rr_remove, _ := dns.NewRR(fmt.Sprintf("%s 0 CNAME", rec_fqdn))
+
+		return dnsRecordSetDelete(meta, rec_zone, dns.TypeCNAME, []dns.RR{rr_remove})
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
@@ -0,0 +1,200 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+func resourceDnsMXRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsMXRecordSetCreate,
+		Read:   resourceDnsMXRecordSetRead,
+		Update: resourceDnsMXRecordSetUpdate,
+		Delete: resourceDnsMXRecordSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mx": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preference": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"exchange": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: resourceDnsMXRecordSetHash,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+func resourceDnsMXRecordSetHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	return schema.HashString(fmt.Sprintf("%d-%s", m["preference"].(int), strings.ToLower(dns.Fqdn(m["exchange"].(string)))))
+}
+
+func resourceDnsMXRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+
+	rec_name := d.Get("name").(string)
+	rec_zone := d.Get("zone").(string)
+
+	if rec_zone != dns.Fqdn(rec_zone) {
+		return fmt.Errorf("Error creating DNS record: \"zone\" should be an FQDN")
+	}
+
+	rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+	d.SetId(rec_fqdn)
+
+	return resourceDnsMXRecordSetUpdate(d, meta)
+}
+
+func resourceDnsMXRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error reading DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		client := meta.(*DNSClient)
+
+		msg := new(dns.Msg)
+		msg.SetQuestion(rec_fqdn, dns.TypeMX)
+
+		r, err := client.exchange(msg)
+		if err != nil {
+			return fmt.Errorf("Error querying DNS record: %s", err)
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			return fmt.Errorf("Error querying DNS record: %v", r.Rcode)
+		}
+
+		mx := schema.NewSet(resourceDnsMXRecordSetHash, nil)
+		for _, record := range r.Answer {
+			rec, err := getMXVal(record)
+			if err != nil {
+				return fmt.Errorf("Error querying DNS record: %s", err)
+			}
+			mx.Add(map[string]interface{}{
+				"preference": int(rec.Preference),
+				"exchange":   strings.ToLower(dns.Fqdn(rec.Mx)),
+			})
+		}
+
+		expected := schema.NewSet(resourceDnsMXRecordSetHash, nil)
+		for _, rec := range d.Get("mx").(*schema.Set).List() {
+			m := rec.(map[string]interface{})
+			expected.Add(map[string]interface{}{
+				"preference": m["preference"].(int),
+				"exchange":   strings.ToLower(dns.Fqdn(m["exchange"].(string))),
+			})
+		}
+		if !mx.Equal(expected) {
+			d.SetId("")
+			return fmt.Errorf("DNS record differs")
+		}
+		return nil
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
+
+func resourceDnsMXRecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+		ttl := d.Get("ttl").(int)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		if d.HasChange("mx") {
+			o, n := d.GetChange("mx")
+			os := o.(*schema.Set)
+			ns := n.(*schema.Set)
+			remove := os.Difference(ns).List()
+			add := ns.Difference(os).List()
+
+			var rr_remove, rr_insert []dns.RR
+
+			for _, rec := range remove {
+				m := rec.(map[string]interface{})
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d MX %d %s", rec_fqdn, ttl, m["preference"].(int), dns.Fqdn(m["exchange"].(string))))
+				rr_remove = append(rr_remove, rr)
+			}
+			for _, rec := range add {
+				m := rec.(map[string]interface{})
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d MX %d %s", rec_fqdn, ttl, m["preference"].(int), dns.Fqdn(m["exchange"].(string))))
+				rr_insert = append(rr_insert, rr)
+			}
+
+			if err := dnsRecordSetUpdate(meta, rec_zone, dns.TypeMX, rr_remove, rr_insert); err != nil {
+				d.SetId("")
+				return err
+			}
+
+			d.Set("mx", ns)
+		}
+
+		return resourceDnsMXRecordSetRead(d, meta)
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
+
+func resourceDnsMXRecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		rr_remove, _ := dns.NewRR(fmt.Sprintf("%s 0 MX", rec_fqdn))
+
+		return dnsRecordSetDelete(meta, rec_zone, dns.TypeMX, []dns.RR{rr_remove})
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
@@ -0,0 +1,175 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+func resourceDnsNSRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsNSRecordSetCreate,
+		Read:   resourceDnsNSRecordSetRead,
+		Update: resourceDnsNSRecordSetUpdate,
+		Delete: resourceDnsNSRecordSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"nameservers": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+func resourceDnsNSRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+
+	rec_name := d.Get("name").(string)
+	rec_zone := d.Get("zone").(string)
+
+	if rec_zone != dns.Fqdn(rec_zone) {
+		return fmt.Errorf("Error creating DNS record: \"zone\" should be an FQDN")
+	}
+
+	rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+	d.SetId(rec_fqdn)
+
+	return resourceDnsNSRecordSetUpdate(d, meta)
+}
+
+func resourceDnsNSRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error reading DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		client := meta.(*DNSClient)
+
+		msg := new(dns.Msg)
+		msg.SetQuestion(rec_fqdn, dns.TypeNS)
+
+		r, err := client.exchange(msg)
+		if err != nil {
+			return fmt.Errorf("Error querying DNS record: %s", err)
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			return fmt.Errorf("Error querying DNS record: %v", r.Rcode)
+		}
+
+		nameservers := schema.NewSet(schema.HashString, nil)
+		for _, record := range r.Answer {
+			ns, err := getNSVal(record)
+			if err != nil {
+				return fmt.Errorf("Error querying DNS record: %s", err)
+			}
+			nameservers.Add(strings.ToLower(dns.Fqdn(ns)))
+		}
+
+		expected := schema.NewSet(schema.HashString, nil)
+		for _, ns := range d.Get("nameservers").(*schema.Set).List() {
+			expected.Add(strings.ToLower(dns.Fqdn(ns.(string))))
+		}
+		if !nameservers.Equal(expected) {
+			d.SetId("")
+			return fmt.Errorf("DNS record differs")
+		}
+		return nil
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
+
+func resourceDnsNSRecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+		ttl := d.Get("ttl").(int)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		if d.HasChange("nameservers") {
+			o, n := d.GetChange("nameservers")
+			os := o.(*schema.Set)
+			ns := n.(*schema.Set)
+			remove := os.Difference(ns).List()
+			add := ns.Difference(os).List()
+
+			var rr_remove, rr_insert []dns.RR
+
+			for _, nameserver := range remove {
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d NS %s", rec_fqdn, ttl, dns.Fqdn(nameserver.(string))))
+				rr_remove = append(rr_remove, rr)
+			}
+			for _, nameserver := range add {
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d NS %s", rec_fqdn, ttl, dns.Fqdn(nameserver.(string))))
+				rr_insert = append(rr_insert, rr)
+			}
+
+			if err := dnsRecordSetUpdate(meta, rec_zone, dns.TypeNS, rr_remove, rr_insert); err != nil {
+				d.SetId("")
+				return err
+			}
+
+			d.Set("nameservers", ns)
+		}
+
+		return resourceDnsNSRecordSetRead(d, meta)
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
+
+func resourceDnsNSRecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		rr_remove, _ := dns.NewRR(fmt.Sprintf("%s 0 NS", rec_fqdn))
+
+		return dnsRecordSetDelete(meta, rec_zone, dns.TypeNS, []dns.RR{rr_remove})
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
@@ -0,0 +1,223 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+func resourceDnsPTRRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsPTRRecordCreate,
+		Read:   resourceDnsPTRRecordRead,
+		Update: resourceDnsPTRRecordUpdate,
+		Delete: resourceDnsPTRRecordDelete,
+
+		Schema: map[string]*schema.Schema{
+			"zone": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"ip_address"},
+			},
+			"name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"ip_address"},
+			},
+			"ip_address": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"zone", "name"},
+			},
+			"ptr": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+func resourceDnsPTRRecordCreate(d *schema.ResourceData, meta interface{}) error {
+
+	if ip_address, ok := d.GetOk("ip_address"); ok {
+		rec_name, rec_zone, err := resourceDnsPTRRecordReverseZone(meta, ip_address.(string))
+		if err != nil {
+			return fmt.Errorf("Error deriving reverse zone for %q: %s", ip_address, err)
+		}
+		d.Set("name", rec_name)
+		d.Set("zone", rec_zone)
+	}
+
+	rec_name := d.Get("name").(string)
+	rec_zone := d.Get("zone").(string)
+
+	if rec_zone != dns.Fqdn(rec_zone) {
+		return fmt.Errorf("Error creating DNS record: \"zone\" should be an FQDN")
+	}
+
+	rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+	d.SetId(rec_fqdn)
+
+	return resourceDnsPTRRecordUpdate(d, meta)
+}
+
+// resourceDnsPTRRecordReverseZone computes the in-addr.arpa/ip6.arpa FQDN for
+// ip_address and splits it into the longest matching authoritative zone (the
+// deepest ancestor for which the update server answers a SOA query) and the
+// record name relative to that zone.
+func resourceDnsPTRRecordReverseZone(meta interface{}, ip_address string) (string, string, error) {
+
+	if !dnsClientConfigured(meta) {
+		return "", "", fmt.Errorf("update server is not set")
+	}
+	if net.ParseIP(ip_address) == nil {
+		return "", "", fmt.Errorf("%q is not a valid IP address", ip_address)
+	}
+
+	arpa_fqdn, err := dns.ReverseAddr(ip_address)
+	if err != nil {
+		return "", "", err
+	}
+
+	client := meta.(*DNSClient)
+
+	labels := dns.SplitDomainName(arpa_fqdn)
+	for i := 0; i < len(labels); i++ {
+		zone := dns.Fqdn(strings.Join(labels[i:], "."))
+
+		msg := new(dns.Msg)
+		msg.SetQuestion(zone, dns.TypeSOA)
+
+		r, err := client.exchange(msg)
+		if err != nil {
+			continue
+		}
+		if r.Rcode == dns.RcodeSuccess && len(r.Answer) > 0 {
+			return strings.Join(labels[:i], "."), zone, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("no authoritative zone found on the update server for %q", arpa_fqdn)
+}
+
+func resourceDnsPTRRecordRead(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error reading DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		client := meta.(*DNSClient)
+
+		msg := new(dns.Msg)
+		msg.SetQuestion(rec_fqdn, dns.TypePTR)
+
+		r, err := client.exchange(msg)
+		if err != nil {
+			return fmt.Errorf("Error querying DNS record: %s", err)
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			return fmt.Errorf("Error querying DNS record: %v", r.Rcode)
+		}
+		if len(r.Answer) > 1 {
+			return fmt.Errorf("Error querying DNS record: multiple responses received")
+		}
+		if len(r.Answer) == 1 {
+			ptr, err := getPTRVal(r.Answer[0])
+			if err != nil {
+				return fmt.Errorf("Error querying DNS record: %s", err)
+			}
+			d.Set("ptr", strings.ToLower(dns.Fqdn(ptr)))
+		} else {
+			d.SetId("")
+		}
+
+		return nil
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
+
+func resourceDnsPTRRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+		ttl := d.Get("ttl").(int)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		if d.HasChange("ptr") {
+			o, n := d.GetChange("ptr")
+
+			var rr_remove, rr_insert []dns.RR
+
+			if o.(string) != "" {
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d PTR %s", rec_fqdn, ttl, dns.Fqdn(o.(string))))
+				rr_remove = append(rr_remove, rr)
+			}
+			if n.(string) != "" {
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d PTR %s", rec_fqdn, ttl, dns.Fqdn(n.(string))))
+				rr_insert = append(rr_insert, rr)
+			}
+
+			if err := dnsRecordSetUpdate(meta, rec_zone, dns.TypePTR, rr_remove, rr_insert); err != nil {
+				d.SetId("")
+				return err
+			}
+
+			d.Set("ptr", n)
+		}
+
+		return resourceDnsPTRRecordRead(d, meta)
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
+
+func resourceDnsPTRRecordDelete(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		rr_remove, _ := dns.NewRR(fmt.Sprintf("%s 0 PTR", rec_fqdn))
+
+		return dnsRecordSetDelete(meta, rec_zone, dns.TypePTR, []dns.RR{rr_remove})
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
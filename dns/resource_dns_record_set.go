@@ -0,0 +1,292 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+// dnsRecordSetTypes lists the rrdata types resourceDnsRecordSet knows how to
+// dispatch, mirroring the per-type resources in this package.
+var dnsRecordSetTypes = map[string]uint16{
+	"A":     dns.TypeA,
+	"AAAA":  dns.TypeAAAA,
+	"CNAME": dns.TypeCNAME,
+	"MX":    dns.TypeMX,
+	"NS":    dns.TypeNS,
+	"PTR":   dns.TypePTR,
+	"SRV":   dns.TypeSRV,
+	"TXT":   dns.TypeTXT,
+}
+
+func resourceDnsRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsRecordSetCreate,
+		Read:   resourceDnsRecordSetRead,
+		Update: resourceDnsRecordSetUpdate,
+		Delete: resourceDnsRecordSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDnsRecordSetType,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3600,
+			},
+			"rrdatas": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					DiffSuppressFunc: resourceDnsRecordSetRRDataDiffSuppress,
+				},
+			},
+		},
+	}
+}
+
+func validateDnsRecordSetType(v interface{}, k string) (ws []string, errors []error) {
+	value := strings.ToUpper(v.(string))
+	if _, ok := dnsRecordSetTypes[value]; !ok {
+		errors = append(errors, fmt.Errorf("%q must be one of A, AAAA, CNAME, MX, NS, PTR, SRV or TXT, got: %s", k, v))
+	}
+	return
+}
+
+// resourceDnsRecordSetRRDataDiffSuppress keeps plans from churning when the
+// server rewrites a value into its canonical form (quoting of TXT strings,
+// expanded IPv6, a trailing dot on CNAME/NS/MX targets).
+func resourceDnsRecordSetRRDataDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	recordType := strings.ToUpper(d.Get("type").(string))
+	return normalizeRRData(recordType, old) == normalizeRRData(recordType, new)
+}
+
+func normalizeRRData(recordType, value string) string {
+	switch recordType {
+	case "AAAA":
+		if ip := net.ParseIP(value); ip != nil {
+			return ip.String()
+		}
+	case "CNAME", "NS", "PTR":
+		return strings.ToLower(dns.Fqdn(value))
+	case "MX":
+		fields := strings.Fields(value)
+		if len(fields) == 2 {
+			return fmt.Sprintf("%s %s", fields[0], strings.ToLower(dns.Fqdn(fields[1])))
+		}
+	case "SRV":
+		fields := strings.Fields(value)
+		if len(fields) == 4 {
+			return fmt.Sprintf("%s %s %s %s", fields[0], fields[1], fields[2], strings.ToLower(dns.Fqdn(fields[3])))
+		}
+	case "TXT":
+		return txtUnquote(value)
+	}
+	return value
+}
+
+// dnsRecordSetRRString builds the rdata portion of an UPDATE RR for a given
+// type, quoting TXT values so they survive dns.NewRR.
+func dnsRecordSetRRString(recordType, value string) string {
+	if recordType == "TXT" {
+		return txtQuote(value)
+	}
+	return value
+}
+
+func resourceDnsRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+
+	rec_name := d.Get("name").(string)
+	rec_zone := d.Get("zone").(string)
+
+	if rec_zone != dns.Fqdn(rec_zone) {
+		return fmt.Errorf("Error creating DNS record: \"zone\" should be an FQDN")
+	}
+
+	rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+	d.SetId(fmt.Sprintf("%s_%s", rec_fqdn, strings.ToUpper(d.Get("type").(string))))
+
+	return resourceDnsRecordSetUpdate(d, meta)
+}
+
+func resourceDnsRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+
+	if !dnsClientConfigured(meta) {
+		return fmt.Errorf("update server is not set")
+	}
+
+	rec_name := d.Get("name").(string)
+	rec_zone := d.Get("zone").(string)
+	recordType := strings.ToUpper(d.Get("type").(string))
+
+	if rec_zone != dns.Fqdn(rec_zone) {
+		return fmt.Errorf("Error reading DNS record: \"zone\" should be an FQDN")
+	}
+
+	rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+	client := meta.(*DNSClient)
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(rec_fqdn, dnsRecordSetTypes[recordType])
+
+	r, err := client.exchange(msg)
+	if err != nil {
+		return fmt.Errorf("Error querying DNS record: %s", err)
+	}
+	if r.Rcode != dns.RcodeSuccess {
+		return fmt.Errorf("Error querying DNS record: %v", r.Rcode)
+	}
+
+	rrdatas := make([]string, 0, len(r.Answer))
+	for _, record := range r.Answer {
+		rrdatas = append(rrdatas, normalizeRRData(recordType, rrDataOf(record)))
+	}
+
+	expected := make([]string, 0, len(d.Get("rrdatas").([]interface{})))
+	for _, v := range d.Get("rrdatas").([]interface{}) {
+		expected = append(expected, normalizeRRData(recordType, v.(string)))
+	}
+
+	if !stringSliceEqualUnordered(rrdatas, expected) {
+		d.SetId("")
+		return fmt.Errorf("DNS record differs")
+	}
+
+	return nil
+}
+
+func resourceDnsRecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	if !dnsClientConfigured(meta) {
+		return fmt.Errorf("update server is not set")
+	}
+
+	rec_name := d.Get("name").(string)
+	rec_zone := d.Get("zone").(string)
+	ttl := d.Get("ttl").(int)
+	recordType := strings.ToUpper(d.Get("type").(string))
+
+	if rec_zone != dns.Fqdn(rec_zone) {
+		return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+	}
+
+	rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+	if d.HasChange("rrdatas") {
+		o, n := d.GetChange("rrdatas")
+		remove := diffRRDataLists(o.([]interface{}), n.([]interface{}))
+		add := diffRRDataLists(n.([]interface{}), o.([]interface{}))
+
+		var rr_remove, rr_insert []dns.RR
+
+		for _, value := range remove {
+			rr, err := dns.NewRR(fmt.Sprintf("%s %d %s %s", rec_fqdn, ttl, recordType, dnsRecordSetRRString(recordType, value)))
+			if err != nil {
+				return fmt.Errorf("Error building DNS record: %s", err)
+			}
+			rr_remove = append(rr_remove, rr)
+		}
+		for _, value := range add {
+			rr, err := dns.NewRR(fmt.Sprintf("%s %d %s %s", rec_fqdn, ttl, recordType, dnsRecordSetRRString(recordType, value)))
+			if err != nil {
+				return fmt.Errorf("Error building DNS record: %s", err)
+			}
+			rr_insert = append(rr_insert, rr)
+		}
+
+		if err := dnsRecordSetUpdate(meta, rec_zone, dnsRecordSetTypes[recordType], rr_remove, rr_insert); err != nil {
+			d.SetId("")
+			return err
+		}
+
+		d.Set("rrdatas", n)
+	}
+
+	return resourceDnsRecordSetRead(d, meta)
+}
+
+func resourceDnsRecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+
+	if !dnsClientConfigured(meta) {
+		return fmt.Errorf("update server is not set")
+	}
+
+	rec_name := d.Get("name").(string)
+	rec_zone := d.Get("zone").(string)
+	recordType := strings.ToUpper(d.Get("type").(string))
+
+	if rec_zone != dns.Fqdn(rec_zone) {
+		return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+	}
+
+	rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+	rr_remove, _ := dns.NewRR(fmt.Sprintf("%s 0 %s", rec_fqdn, recordType))
+
+	return dnsRecordSetDelete(meta, rec_zone, dnsRecordSetTypes[recordType], []dns.RR{rr_remove})
+}
+
+// rrDataOf returns the rdata portion of a resource record's presentation
+// format, i.e. everything after the Name/TTL/Class/Type header fields.
+func rrDataOf(rr dns.RR) string {
+	fields := strings.SplitN(rr.String(), "\t", 5)
+	if len(fields) == 5 {
+		return fields[4]
+	}
+	return ""
+}
+
+func diffRRDataLists(a, b []interface{}) []string {
+	inB := make(map[string]bool, len(b))
+	for _, v := range b {
+		inB[v.(string)] = true
+	}
+
+	var diff []string
+	for _, v := range a {
+		if !inB[v.(string)] {
+			diff = append(diff, v.(string))
+		}
+	}
+	return diff
+}
+
+func stringSliceEqualUnordered(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[string]int, len(a))
+	for _, v := range a {
+		counts[v]++
+	}
+	for _, v := range b {
+		counts[v]--
+	}
+	for _, c := range counts {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,214 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+func resourceDnsSRVRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsSRVRecordSetCreate,
+		Read:   resourceDnsSRVRecordSetRead,
+		Update: resourceDnsSRVRecordSetUpdate,
+		Delete: resourceDnsSRVRecordSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"srv": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"weight": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"port": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"target": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: resourceDnsSRVRecordSetHash,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+func resourceDnsSRVRecordSetHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	return schema.HashString(fmt.Sprintf("%d-%d-%d-%s", m["priority"].(int), m["weight"].(int), m["port"].(int), strings.ToLower(dns.Fqdn(m["target"].(string)))))
+}
+
+func resourceDnsSRVRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+
+	rec_name := d.Get("name").(string)
+	rec_zone := d.Get("zone").(string)
+
+	if rec_zone != dns.Fqdn(rec_zone) {
+		return fmt.Errorf("Error creating DNS record: \"zone\" should be an FQDN")
+	}
+
+	rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+	d.SetId(rec_fqdn)
+
+	return resourceDnsSRVRecordSetUpdate(d, meta)
+}
+
+func resourceDnsSRVRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error reading DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		client := meta.(*DNSClient)
+
+		msg := new(dns.Msg)
+		msg.SetQuestion(rec_fqdn, dns.TypeSRV)
+
+		r, err := client.exchange(msg)
+		if err != nil {
+			return fmt.Errorf("Error querying DNS record: %s", err)
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			return fmt.Errorf("Error querying DNS record: %v", r.Rcode)
+		}
+
+		srv := schema.NewSet(resourceDnsSRVRecordSetHash, nil)
+		for _, record := range r.Answer {
+			rec, err := getSRVVal(record)
+			if err != nil {
+				return fmt.Errorf("Error querying DNS record: %s", err)
+			}
+			srv.Add(map[string]interface{}{
+				"priority": int(rec.Priority),
+				"weight":   int(rec.Weight),
+				"port":     int(rec.Port),
+				"target":   strings.ToLower(dns.Fqdn(rec.Target)),
+			})
+		}
+
+		expected := schema.NewSet(resourceDnsSRVRecordSetHash, nil)
+		for _, rec := range d.Get("srv").(*schema.Set).List() {
+			m := rec.(map[string]interface{})
+			expected.Add(map[string]interface{}{
+				"priority": m["priority"].(int),
+				"weight":   m["weight"].(int),
+				"port":     m["port"].(int),
+				"target":   strings.ToLower(dns.Fqdn(m["target"].(string))),
+			})
+		}
+		if !srv.Equal(expected) {
+			d.SetId("")
+			return fmt.Errorf("DNS record differs")
+		}
+		return nil
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
+
+func resourceDnsSRVRecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+		ttl := d.Get("ttl").(int)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		if d.HasChange("srv") {
+			o, n := d.GetChange("srv")
+			os := o.(*schema.Set)
+			ns := n.(*schema.Set)
+			remove := os.Difference(ns).List()
+			add := ns.Difference(os).List()
+
+			var rr_remove, rr_insert []dns.RR
+
+			for _, rec := range remove {
+				m := rec.(map[string]interface{})
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d SRV %d %d %d %s", rec_fqdn, ttl,
+					m["priority"].(int), m["weight"].(int), m["port"].(int), dns.Fqdn(m["target"].(string))))
+				rr_remove = append(rr_remove, rr)
+			}
+			for _, rec := range add {
+				m := rec.(map[string]interface{})
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d SRV %d %d %d %s", rec_fqdn, ttl,
+					m["priority"].(int), m["weight"].(int), m["port"].(int), dns.Fqdn(m["target"].(string))))
+				rr_insert = append(rr_insert, rr)
+			}
+
+			if err := dnsRecordSetUpdate(meta, rec_zone, dns.TypeSRV, rr_remove, rr_insert); err != nil {
+				d.SetId("")
+				return err
+			}
+
+			d.Set("srv", ns)
+		}
+
+		return resourceDnsSRVRecordSetRead(d, meta)
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
+
+func resourceDnsSRVRecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		rr_remove, _ := dns.NewRR(fmt.Sprintf("%s 0 SRV", rec_fqdn))
+
+		return dnsRecordSetDelete(meta, rec_zone, dns.TypeSRV, []dns.RR{rr_remove})
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
@@ -0,0 +1,193 @@
+package dns
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/miekg/dns"
+)
+
+// txtChunkLen is the maximum length of a single character-string within a
+// TXT record's rdata, per RFC 1035 section 3.3.
+const txtChunkLen = 255
+
+func resourceDnsTXTRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsTXTRecordSetCreate,
+		Read:   resourceDnsTXTRecordSetRead,
+		Update: resourceDnsTXTRecordSetUpdate,
+		Delete: resourceDnsTXTRecordSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"txt": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+// txtQuote splits a value into <= 255 byte chunks and quotes each one, so
+// values longer than a single character-string round-trip through dns.NewRR.
+func txtQuote(value string) string {
+	var chunks []string
+	for len(value) > txtChunkLen {
+		chunks = append(chunks, value[:txtChunkLen])
+		value = value[txtChunkLen:]
+	}
+	chunks = append(chunks, value)
+
+	quoted := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		quoted[i] = strconv.Quote(chunk)
+	}
+	return strings.Join(quoted, " ")
+}
+
+func resourceDnsTXTRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+
+	rec_name := d.Get("name").(string)
+	rec_zone := d.Get("zone").(string)
+
+	if rec_zone != dns.Fqdn(rec_zone) {
+		return fmt.Errorf("Error creating DNS record: \"zone\" should be an FQDN")
+	}
+
+	rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+	d.SetId(rec_fqdn)
+
+	return resourceDnsTXTRecordSetUpdate(d, meta)
+}
+
+func resourceDnsTXTRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error reading DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		client := meta.(*DNSClient)
+
+		msg := new(dns.Msg)
+		msg.SetQuestion(rec_fqdn, dns.TypeTXT)
+
+		r, err := client.exchange(msg)
+		if err != nil {
+			return fmt.Errorf("Error querying DNS record: %s", err)
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			return fmt.Errorf("Error querying DNS record: %v", r.Rcode)
+		}
+
+		texts := schema.NewSet(schema.HashString, nil)
+		for _, record := range r.Answer {
+			txt, err := getTXTVal(record)
+			if err != nil {
+				return fmt.Errorf("Error querying DNS record: %s", err)
+			}
+			texts.Add(txt)
+		}
+
+		if !texts.Equal(d.Get("txt").(*schema.Set)) {
+			d.SetId("")
+			return fmt.Errorf("DNS record differs")
+		}
+		return nil
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
+
+func resourceDnsTXTRecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+		ttl := d.Get("ttl").(int)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		if d.HasChange("txt") {
+			o, n := d.GetChange("txt")
+			os := o.(*schema.Set)
+			ns := n.(*schema.Set)
+			remove := os.Difference(ns).List()
+			add := ns.Difference(os).List()
+
+			var rr_remove, rr_insert []dns.RR
+
+			for _, txt := range remove {
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d TXT %s", rec_fqdn, ttl, txtQuote(txt.(string))))
+				rr_remove = append(rr_remove, rr)
+			}
+			for _, txt := range add {
+				rr, _ := dns.NewRR(fmt.Sprintf("%s %d TXT %s", rec_fqdn, ttl, txtQuote(txt.(string))))
+				rr_insert = append(rr_insert, rr)
+			}
+
+			if err := dnsRecordSetUpdate(meta, rec_zone, dns.TypeTXT, rr_remove, rr_insert); err != nil {
+				d.SetId("")
+				return err
+			}
+
+			d.Set("txt", ns)
+		}
+
+		return resourceDnsTXTRecordSetRead(d, meta)
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
+
+func resourceDnsTXTRecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+
+	if dnsClientConfigured(meta) {
+
+		rec_name := d.Get("name").(string)
+		rec_zone := d.Get("zone").(string)
+
+		if rec_zone != dns.Fqdn(rec_zone) {
+			return fmt.Errorf("Error updating DNS record: \"zone\" should be an FQDN")
+		}
+
+		rec_fqdn := fmt.Sprintf("%s.%s", rec_name, rec_zone)
+
+		rr_remove, _ := dns.NewRR(fmt.Sprintf("%s 0 TXT", rec_fqdn))
+
+		return dnsRecordSetDelete(meta, rec_zone, dns.TypeTXT, []dns.RR{rr_remove})
+	} else {
+		return fmt.Errorf("update server is not set")
+	}
+}
@@ -0,0 +1,110 @@
+package dns
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/miekg/dns"
+)
+
+// tlsClientConfig builds the *tls.Config used for the "tls" transport from
+// the provider's tls_server_name/tls_ca_file/tls_insecure_skip_verify
+// settings.
+func tlsClientConfig(c *Config) (*tls.Config, error) {
+
+	tlsConfig := &tls.Config{
+		ServerName:         c.tlsServerName,
+		InsecureSkipVerify: c.tlsInsecureSkipVerify,
+	}
+
+	if c.tlsCAFile != "" {
+		pem, err := ioutil.ReadFile(c.tlsCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading \"tls_ca_file\": %s", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("Error parsing \"tls_ca_file\": no certificates found in %s", c.tlsCAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// exchange sends msg to the configured update server. For the "tcp" and
+// "tls" transports it draws a connection from a small pool (dialing a fresh
+// one if the pool is empty) and returns it to the pool afterwards, so
+// concurrent resource operations -- Terraform runs CRUD across resources in
+// parallel by default -- amortize handshake cost across a pool of reused
+// connections instead of taking turns on a single one. The "udp" transport
+// (the default) is connectionless, so it falls back to a plain one-shot
+// exchange.
+func (c *DNSClient) exchange(msg *dns.Msg) (*dns.Msg, error) {
+
+	if c.gssapi != nil {
+		// c.c.TsigProvider is read by (*dns.Client).Exchange/ExchangeWithConn
+		// with no locking of its own, and is mutated by ensureGSSContext
+		// under gssMu during renegotiation. Hold the read side for the
+		// duration of the exchange so it can never race that write.
+		c.gssMu.RLock()
+		defer c.gssMu.RUnlock()
+	}
+
+	if c.connPool == nil {
+		r, _, err := c.c.Exchange(msg, c.srv_addr)
+		return r, err
+	}
+
+	var conn *dns.Conn
+	select {
+	case conn = <-c.connPool:
+	default:
+	}
+
+	pooled := conn != nil
+
+	if conn == nil {
+		var err error
+		conn, err = c.c.Dial(c.srv_addr)
+		if err != nil {
+			return nil, fmt.Errorf("Error connecting to %s: %s", c.srv_addr, err)
+		}
+	}
+
+	r, _, err := c.c.ExchangeWithConn(msg, conn)
+	if err != nil {
+		conn.Close()
+
+		if !pooled {
+			return nil, err
+		}
+
+		// The pooled connection may have gone stale (idle-timed-out or
+		// closed by the server) since it was returned to the pool; redial
+		// once and retry. A failure on the redialed connection is returned,
+		// as it is for a connection that was freshly dialed in the first place.
+		conn, err = c.c.Dial(c.srv_addr)
+		if err != nil {
+			return nil, fmt.Errorf("Error connecting to %s: %s", c.srv_addr, err)
+		}
+
+		r, _, err = c.c.ExchangeWithConn(msg, conn)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	select {
+	case c.connPool <- conn:
+	default:
+		// Pool is full; close the now-idle connection rather than block.
+		conn.Close()
+	}
+
+	return r, nil
+}
@@ -0,0 +1,125 @@
+package dns
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// getAVal returns the normalized IPv4 address carried by an A record.
+func getAVal(rec dns.RR) (string, error) {
+	switch r := rec.(type) {
+	case *dns.A:
+		return r.A.String(), nil
+	default:
+		return "", fmt.Errorf("didn't get an A record")
+	}
+}
+
+// getAAAAVal returns the IPv6 address carried by an AAAA record in its
+// canonical (compressed) textual form, as produced by net.IP.String.
+func getAAAAVal(rec dns.RR) (string, error) {
+	switch r := rec.(type) {
+	case *dns.AAAA:
+		return r.AAAA.String(), nil
+	default:
+		return "", fmt.Errorf("didn't get an AAAA record")
+	}
+}
+
+// getCNAMEVal returns the lower-cased, FQDN target of a CNAME record, so
+// callers get a consistently formatted value regardless of the case or
+// trailing dot the server returns it with.
+func getCNAMEVal(rec dns.RR) (string, error) {
+	switch r := rec.(type) {
+	case *dns.CNAME:
+		return strings.ToLower(dns.Fqdn(r.Target)), nil
+	default:
+		return "", fmt.Errorf("didn't get a CNAME record")
+	}
+}
+
+// getMXVal returns the preference and exchange of an MX record.
+func getMXVal(rec dns.RR) (*dns.MX, error) {
+	switch r := rec.(type) {
+	case *dns.MX:
+		return r, nil
+	default:
+		return nil, fmt.Errorf("didn't get an MX record")
+	}
+}
+
+// getNSVal returns the target of an NS record.
+func getNSVal(rec dns.RR) (string, error) {
+	switch r := rec.(type) {
+	case *dns.NS:
+		return r.Ns, nil
+	default:
+		return "", fmt.Errorf("didn't get a NS record")
+	}
+}
+
+// getPTRVal returns the target of a PTR record.
+func getPTRVal(rec dns.RR) (string, error) {
+	switch r := rec.(type) {
+	case *dns.PTR:
+		return r.Ptr, nil
+	default:
+		return "", fmt.Errorf("didn't get a PTR record")
+	}
+}
+
+// getSRVVal returns the priority/weight/port/target of an SRV record.
+func getSRVVal(rec dns.RR) (*dns.SRV, error) {
+	switch r := rec.(type) {
+	case *dns.SRV:
+		return r, nil
+	default:
+		return nil, fmt.Errorf("didn't get a SRV record")
+	}
+}
+
+// getTXTVal reassembles the (possibly chunked) strings of a TXT record into
+// a single value.
+func getTXTVal(rec dns.RR) (string, error) {
+	switch r := rec.(type) {
+	case *dns.TXT:
+		txt := ""
+		for _, s := range r.Txt {
+			txt += s
+		}
+		return txt, nil
+	default:
+		return "", fmt.Errorf("didn't get a TXT record")
+	}
+}
+
+// txtChunkRE matches a single quoted character-string within a TXT record's
+// rdata in presentation format, e.g. one of the two chunks in
+// `"chunk1" "chunk2"`.
+var txtChunkRE = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+
+// txtUnquote reassembles a TXT rdata string in presentation format -- one or
+// more quoted, possibly chunked, character-strings -- back into the single
+// unquoted value it represents, the same way getTXTVal does for an already
+// parsed *dns.TXT. Unlike strconv.Unquote, this handles the multi-chunk form
+// the server returns for any value over 255 bytes.
+func txtUnquote(value string) string {
+	chunks := txtChunkRE.FindAllString(value, -1)
+	if chunks == nil {
+		return value
+	}
+
+	txt := ""
+	for _, chunk := range chunks {
+		unquoted, err := strconv.Unquote(chunk)
+		if err != nil {
+			return value
+		}
+		txt += unquoted
+	}
+	return txt
+}